@@ -0,0 +1,139 @@
+/*
+   Better Posture - A posture reminder utility to promote ergonomic habits.
+   Copyright (C) 2025  Rodrigo Toraño Valle
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package audio plays the short embedded cues that accompany a posture reminder, for
+// users who keep toast notifications suppressed and would otherwise miss them.
+package audio
+
+import (
+	"bytes"
+	"embed"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/hajimehoshi/oto/v2"
+	"github.com/hraban/opus"
+)
+
+// clips are pre-encoded at build time (see tools/encode_clips.go) as a simple
+// length-prefixed stream of raw Opus frames rather than a full Ogg container, which
+// keeps this decoder small. The checked-in files are empty stubs so the embed
+// compiles; Play refuses to play them until tools/encode_clips.go has been run against
+// real recordings, instead of silently succeeding with no sound.
+//
+//go:embed clips/chime.opus clips/bell.opus clips/beep.opus
+var clipFS embed.FS
+
+// Names lists the embedded reminder sounds, in the order offered on the tray menu.
+var Names = []string{"chime", "bell", "beep"}
+
+const (
+	sampleRate = 48000
+	channels   = 1
+	frameSize  = sampleRate / 50 // 20ms frames, the Opus frame size used when encoding the clips
+)
+
+var (
+	playMu  sync.Mutex
+	ctxOnce sync.Once
+	otoCtx  *oto.Context
+	ctxErr  error
+)
+
+func context() (*oto.Context, error) {
+	ctxOnce.Do(func() {
+		otoCtx, _, ctxErr = oto.NewContext(sampleRate, channels, 2)
+	})
+	return otoCtx, ctxErr
+}
+
+func decode(raw []byte) ([]byte, error) {
+	dec, err := opus.NewDecoder(sampleRate, channels)
+	if err != nil {
+		return nil, fmt.Errorf("creating opus decoder: %w", err)
+	}
+
+	var pcm bytes.Buffer
+	r := bytes.NewReader(raw)
+	frame := make([]int16, frameSize)
+
+	for {
+		var frameLen uint16
+		if err := binary.Read(r, binary.LittleEndian, &frameLen); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("reading frame header: %w", err)
+		}
+
+		packet := make([]byte, frameLen)
+		if _, err := io.ReadFull(r, packet); err != nil {
+			return nil, fmt.Errorf("reading frame payload: %w", err)
+		}
+
+		n, err := dec.Decode(packet, frame)
+		if err != nil {
+			return nil, fmt.Errorf("decoding opus frame: %w", err)
+		}
+
+		for _, sample := range frame[:n] {
+			_ = binary.Write(&pcm, binary.LittleEndian, sample)
+		}
+	}
+
+	return pcm.Bytes(), nil
+}
+
+// Play decodes and plays the named embedded clip, blocking until playback finishes.
+// Call it from its own goroutine; concurrent calls are serialized so two reminders
+// firing close together never play on top of each other.
+func Play(name string) error {
+	playMu.Lock()
+	defer playMu.Unlock()
+
+	data, err := clipFS.ReadFile(fmt.Sprintf("clips/%s.opus", name))
+	if err != nil {
+		return fmt.Errorf("reading embedded clip %q: %w", name, err)
+	}
+	if len(data) == 0 {
+		return fmt.Errorf("clip %q has no audio encoded yet (run tools/encode_clips.go against a real recording)", name)
+	}
+
+	pcm, err := decode(data)
+	if err != nil {
+		return fmt.Errorf("decoding embedded clip %q: %w", name, err)
+	}
+
+	octx, err := context()
+	if err != nil {
+		return fmt.Errorf("initializing audio output: %w", err)
+	}
+
+	player := octx.NewPlayer(bytes.NewReader(pcm))
+	defer player.Close()
+
+	player.Play()
+	for player.IsPlaying() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+/*
+   Better Posture - A posture reminder utility to promote ergonomic habits.
+   Copyright (C) 2025  Rodrigo Toraño Valle
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package idle reports how long the user has gone without touching the keyboard or
+// mouse, so reminders can skip themselves while the user is already away.
+package idle
+
+import (
+	"fmt"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// Source abstracts the idle-time reading so the threshold logic can be unit-tested
+// without making real Win32 calls.
+type Source interface {
+	Idle() (time.Duration, error)
+}
+
+type lastInputInfo struct {
+	cbSize uint32
+	dwTime uint32
+}
+
+var (
+	user32               = windows.NewLazySystemDLL("user32.dll")
+	procGetLastInputInfo = user32.NewProc("GetLastInputInfo")
+
+	kernel32         = windows.NewLazySystemDLL("kernel32.dll")
+	procGetTickCount = kernel32.NewProc("GetTickCount")
+)
+
+type windowsSource struct{}
+
+func (windowsSource) Idle() (time.Duration, error) {
+	var info lastInputInfo
+	info.cbSize = uint32(unsafe.Sizeof(info))
+
+	ret, _, callErr := procGetLastInputInfo.Call(uintptr(unsafe.Pointer(&info)))
+	if ret == 0 {
+		return 0, fmt.Errorf("GetLastInputInfo failed: %w", callErr)
+	}
+
+	tick, _, _ := procGetTickCount.Call()
+	elapsedMillis := uint32(tick) - info.dwTime
+	return time.Duration(elapsedMillis) * time.Millisecond, nil
+}
+
+// Default is the real Windows idle source, used everywhere except tests.
+var Default Source = windowsSource{}
+
+// Exceeds reports whether src's current idle duration is at or beyond threshold. A
+// threshold of zero or less disables idle detection entirely. Any error from src is
+// treated as "not idle" so a detection failure never blocks reminders.
+func Exceeds(src Source, threshold time.Duration) bool {
+	if threshold <= 0 {
+		return false
+	}
+
+	d, err := src.Idle()
+	if err != nil {
+		fmt.Printf("Warning: could not read idle time: %v\n", err)
+		return false
+	}
+
+	return d >= threshold
+}
@@ -0,0 +1,64 @@
+/*
+   Better Posture - A posture reminder utility to promote ergonomic habits.
+   Copyright (C) 2025  Rodrigo Toraño Valle
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package idle
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeSource struct {
+	d   time.Duration
+	err error
+}
+
+func (f fakeSource) Idle() (time.Duration, error) {
+	return f.d, f.err
+}
+
+func TestExceeds(t *testing.T) {
+	cases := []struct {
+		name      string
+		idle      time.Duration
+		threshold time.Duration
+		want      bool
+	}{
+		{"below threshold", 1 * time.Minute, 2 * time.Minute, false},
+		{"at threshold", 2 * time.Minute, 2 * time.Minute, true},
+		{"above threshold", 5 * time.Minute, 2 * time.Minute, true},
+		{"zero threshold disables detection", 10 * time.Minute, 0, false},
+		{"negative threshold disables detection", 10 * time.Minute, -1 * time.Minute, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := Exceeds(fakeSource{d: c.idle}, c.threshold); got != c.want {
+				t.Errorf("Exceeds(%v, %v) = %v, want %v", c.idle, c.threshold, got, c.want)
+			}
+		})
+	}
+}
+
+func TestExceedsTreatsSourceErrorAsNotIdle(t *testing.T) {
+	src := fakeSource{d: 10 * time.Minute, err: errors.New("boom")}
+	if Exceeds(src, 2*time.Minute) {
+		t.Error("expected a source error to be treated as not idle")
+	}
+}
@@ -24,8 +24,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/fs"
+	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -34,8 +37,13 @@ import (
 
 	"golang.org/x/sys/windows"
 
+	"github.com/fsnotify/fsnotify"
 	"github.com/getlantern/systray"
 	"github.com/go-toast/toast"
+
+	"better-posture/audio"
+	"better-posture/idle"
+	"better-posture/scripts"
 )
 
 //go:embed assets/icon.ico
@@ -54,8 +62,30 @@ const (
 
 	minInterval = 1       // minutes
 	maxInterval = 24 * 60 // 1440 minutes (24 hours)
+
+	defaultSoundName = "chime"
+
+	defaultIdleSkipMinutes = 2
+
+	defaultScheduleStart = "09:00"
+	defaultScheduleEnd   = "17:00"
+)
+
+// defaultScheduleDays lists the weekdays a freshly created Schedule applies to.
+var defaultScheduleDays = []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}
+
+var weekdayNames = []string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+const (
+	updateCheckEnvVar  = "BETTER_POSTURE_UPDATE"
+	updateManifestURL  = "https://better-posture.rtorval.dev/release.json"
+	updateCheckTimeout = 5 * time.Second
+	updateCheckEvery   = 24 * time.Hour
 )
 
+// buildVersion is overridden at release build time via -ldflags "-X main.buildVersion=vX.Y.Z".
+var buildVersion = "0.0.0-dev"
+
 const (
 	MB_ICONINFORMATION = 0x00000040
 	MB_ICONWARNING     = 0x00000030
@@ -69,9 +99,23 @@ var (
 )
 
 type Config struct {
-	IntervalMinutes int    `json:"interval_minutes"`
-	ReminderTitle   string `json:"reminder_title"`
-	ReminderMessage string `json:"reminder_message"`
+	IntervalMinutes    int            `json:"interval_minutes"`
+	ReminderTitle      string         `json:"reminder_title"`
+	ReminderMessage    string         `json:"reminder_message"`
+	LastUpdateCheckUTC int64          `json:"last_update_check_utc"`
+	SoundEnabled       bool           `json:"sound_enabled"`
+	SoundName          string         `json:"sound_name"`
+	IdleSkipMinutes    int            `json:"idle_skip_minutes"`
+	Schedule           ScheduleConfig `json:"schedule"`
+}
+
+// ScheduleConfig defines a quiet-hours / work-schedule window: when Enabled, reminders
+// only fire between Start and End (each "HH:MM", 24h clock) on the listed Days.
+type ScheduleConfig struct {
+	Enabled bool     `json:"enabled"`
+	Start   string   `json:"start"`
+	End     string   `json:"end"`
+	Days    []string `json:"days"`
 }
 
 func settingsPath() string {
@@ -185,11 +229,7 @@ func ensureResourceFiles() {
 }
 
 func loadConfig() Config {
-	defaultCfg := Config{
-		IntervalMinutes: defaultInterval,
-		ReminderTitle:   defaultReminderTitle,
-		ReminderMessage: defaultReminderMessage,
-	}
+	defaultCfg := defaultConfig()
 
 	p := settingsPath()
 	data, err := os.ReadFile(p)
@@ -209,6 +249,40 @@ func loadConfig() Config {
 		return defaultCfg
 	}
 
+	cfg, needsSave := sanitizeConfig(cfg)
+	if needsSave {
+		if saveErr := saveConfig(cfg); saveErr != nil {
+			fmt.Printf("Warning: could not save adjusted config: %v\n", saveErr)
+		}
+	}
+
+	return cfg
+}
+
+func defaultConfig() Config {
+	return Config{
+		IntervalMinutes: defaultInterval,
+		ReminderTitle:   defaultReminderTitle,
+		ReminderMessage: defaultReminderMessage,
+		SoundEnabled:    false,
+		SoundName:       defaultSoundName,
+		IdleSkipMinutes: defaultIdleSkipMinutes,
+		Schedule:        defaultSchedule(),
+	}
+}
+
+func defaultSchedule() ScheduleConfig {
+	return ScheduleConfig{
+		Enabled: false,
+		Start:   defaultScheduleStart,
+		End:     defaultScheduleEnd,
+		Days:    append([]string(nil), defaultScheduleDays...),
+	}
+}
+
+// sanitizeConfig clamps/fills any out-of-range or missing fields to their defaults,
+// reporting whether it changed anything so the caller can decide to persist the result.
+func sanitizeConfig(cfg Config) (Config, bool) {
 	needsSave := false
 
 	if cfg.IntervalMinutes < minInterval {
@@ -231,13 +305,106 @@ func loadConfig() Config {
 		needsSave = true
 	}
 
-	if needsSave {
-		if saveErr := saveConfig(cfg); saveErr != nil {
-			fmt.Printf("Warning: could not save adjusted config: %v\n", saveErr)
+	if !isValidSoundName(cfg.SoundName) {
+		cfg.SoundName = defaultSoundName
+		needsSave = true
+	}
+
+	if cfg.IdleSkipMinutes <= 0 {
+		cfg.IdleSkipMinutes = defaultIdleSkipMinutes
+		needsSave = true
+	}
+
+	if !isValidTimeOfDay(cfg.Schedule.Start) {
+		cfg.Schedule.Start = defaultScheduleStart
+		needsSave = true
+	}
+
+	if !isValidTimeOfDay(cfg.Schedule.End) {
+		cfg.Schedule.End = defaultScheduleEnd
+		needsSave = true
+	}
+
+	if !areValidWeekdays(cfg.Schedule.Days) {
+		cfg.Schedule.Days = append([]string(nil), defaultScheduleDays...)
+		needsSave = true
+	}
+
+	return cfg, needsSave
+}
+
+// reloadConfigFromDisk re-reads and validates the settings file, treating a missing
+// file as a soft error that restores defaults instead of propagating an error.
+func reloadConfigFromDisk(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return defaultConfig(), nil
 		}
+		return Config{}, fmt.Errorf("reading settings file: %w", err)
 	}
 
-	return cfg
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("parsing settings file: %w", err)
+	}
+
+	cfg, _ = sanitizeConfig(cfg)
+	return cfg, nil
+}
+
+// watchSettingsFile watches path for changes and, after debouncing events for the given
+// duration, calls onChange with the freshly reloaded config. This lets users hand-edit
+// settings.json (or sync it via Dropbox/OneDrive) and see it take effect without
+// restarting. The returned watcher must be closed by the caller on shutdown.
+func watchSettingsFile(path string, debounce time.Duration, onChange func(Config)) (*fsnotify.Watcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("creating settings watcher: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("watching settings directory %s: %w", dir, err)
+	}
+
+	go func() {
+		var timer *time.Timer
+
+		reload := func() {
+			cfg, err := reloadConfigFromDisk(path)
+			if err != nil {
+				fmt.Printf("Warning: %v — keeping current settings\n", err)
+				return
+			}
+			onChange(cfg)
+		}
+
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Name != path || event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove) == 0 {
+					continue
+				}
+				if timer != nil {
+					timer.Stop()
+				}
+				timer = time.AfterFunc(debounce, reload)
+
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				fmt.Printf("Settings watcher error: %v\n", err)
+			}
+		}
+	}()
+
+	return watcher, nil
 }
 
 func saveConfig(cfg Config) error {
@@ -251,6 +418,245 @@ func saveConfig(cfg Config) error {
 	return nil
 }
 
+// updateManifest mirrors the JSON document published at updateManifestURL.
+type updateManifest struct {
+	Version     string `json:"version"`
+	DownloadURL string `json:"download_url"`
+}
+
+var (
+	updateMu          sync.RWMutex
+	updateAvailable   bool
+	updateVersion     string
+	updateDownloadURL string
+)
+
+func updateCheckDisabled() bool {
+	return strings.EqualFold(os.Getenv(updateCheckEnvVar), "off")
+}
+
+func fetchUpdateManifest(url string) (updateManifest, error) {
+	client := &http.Client{Timeout: updateCheckTimeout}
+	resp, err := client.Get(url)
+	if err != nil {
+		return updateManifest{}, fmt.Errorf("fetching update manifest: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return updateManifest{}, fmt.Errorf("update manifest returned status %d", resp.StatusCode)
+	}
+
+	var m updateManifest
+	if err := json.NewDecoder(resp.Body).Decode(&m); err != nil {
+		return updateManifest{}, fmt.Errorf("decoding update manifest: %w", err)
+	}
+	return m, nil
+}
+
+// versionParts splits a "vX.Y.Z" (or "X.Y.Z") string into its numeric components.
+func versionParts(v string) []int {
+	v = strings.TrimPrefix(v, "v")
+	fields := strings.Split(v, ".")
+	parts := make([]int, len(fields))
+	for i, f := range fields {
+		n, _ := strconv.Atoi(f)
+		parts[i] = n
+	}
+	return parts
+}
+
+// isOlderVersion reports whether current is older than latest under simple semver ordering.
+func isOlderVersion(current, latest string) bool {
+	cur := versionParts(current)
+	lat := versionParts(latest)
+	for i := 0; i < len(cur) || i < len(lat); i++ {
+		var c, l int
+		if i < len(cur) {
+			c = cur[i]
+		}
+		if i < len(lat) {
+			l = lat[i]
+		}
+		if c != l {
+			return c < l
+		}
+	}
+	return false
+}
+
+// computeUpdateMessage builds the user-facing string shown in the tray and About dialog
+// once a release newer than the running build has been found.
+func computeUpdateMessage(downloadURL string, older bool) string {
+	if !older {
+		return ""
+	}
+	if downloadURL == "" {
+		return "A new version of Better Posture is available."
+	}
+	return fmt.Sprintf("A new version of Better Posture is available: %s", downloadURL)
+}
+
+// checkForUpdate fetches the release manifest, compares it against buildVersion, and
+// records the result for the tray menu and showAbout to pick up. The fetch blocks for at
+// most updateCheckTimeout; errors are logged and swallowed, never returned.
+func checkForUpdate(cfg *Config) {
+	if updateCheckDisabled() {
+		return
+	}
+
+	cfgMutex.RLock()
+	lastChecked := cfg.LastUpdateCheckUTC
+	cfgMutex.RUnlock()
+
+	if time.Since(time.Unix(lastChecked, 0)) < updateCheckEvery {
+		return
+	}
+
+	manifest, err := fetchUpdateManifest(updateManifestURL)
+
+	cfgMutex.Lock()
+	cfg.LastUpdateCheckUTC = time.Now().Unix()
+	saveErr := saveConfig(*cfg)
+	cfgMutex.Unlock()
+	if saveErr != nil {
+		fmt.Printf("Warning: could not persist last update check time: %v\n", saveErr)
+	}
+
+	if err != nil {
+		fmt.Printf("Update check failed: %v\n", err)
+		return
+	}
+
+	if !isOlderVersion(buildVersion, manifest.Version) {
+		return
+	}
+
+	updateMu.Lock()
+	updateAvailable = true
+	updateVersion = manifest.Version
+	updateDownloadURL = manifest.DownloadURL
+	updateMu.Unlock()
+}
+
+// parseTimeOfDay parses an "HH:MM" 24h-clock string.
+func parseTimeOfDay(s string) (hour, minute int, ok bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 || len(parts[0]) != 2 || len(parts[1]) != 2 {
+		return 0, 0, false
+	}
+
+	h, err := strconv.Atoi(parts[0])
+	if err != nil || h < 0 || h > 23 {
+		return 0, 0, false
+	}
+
+	m, err := strconv.Atoi(parts[1])
+	if err != nil || m < 0 || m > 59 {
+		return 0, 0, false
+	}
+
+	return h, m, true
+}
+
+func isValidTimeOfDay(s string) bool {
+	_, _, ok := parseTimeOfDay(s)
+	return ok
+}
+
+func isValidWeekday(name string) bool {
+	for _, d := range weekdayNames {
+		if strings.EqualFold(d, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func areValidWeekdays(days []string) bool {
+	if len(days) == 0 {
+		return false
+	}
+	for _, d := range days {
+		if !isValidWeekday(d) {
+			return false
+		}
+	}
+	return true
+}
+
+func isScheduledDay(weekday time.Weekday, days []string) bool {
+	for _, d := range days {
+		if strings.EqualFold(d, weekday.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// withinSchedule reports whether now falls inside the configured work-schedule window.
+// A disabled schedule always reports true (no restriction). The window may wrap past
+// midnight (e.g. Start "22:00", End "06:00").
+func withinSchedule(now time.Time, sched ScheduleConfig) bool {
+	if !sched.Enabled {
+		return true
+	}
+
+	if !isScheduledDay(now.Weekday(), sched.Days) {
+		return false
+	}
+
+	startH, startM, _ := parseTimeOfDay(sched.Start)
+	endH, endM, _ := parseTimeOfDay(sched.End)
+
+	start := time.Date(now.Year(), now.Month(), now.Day(), startH, startM, 0, 0, now.Location())
+	end := time.Date(now.Year(), now.Month(), now.Day(), endH, endM, 0, 0, now.Location())
+
+	if end.Before(start) {
+		return !now.Before(start) || now.Before(end)
+	}
+	return !now.Before(start) && now.Before(end)
+}
+
+func scheduleToggleLabel(enabled bool) string {
+	if enabled {
+		return "Disable work schedule"
+	}
+	return "Enable work schedule"
+}
+
+func isValidSoundName(name string) bool {
+	for _, n := range audio.Names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// nextSoundName cycles to the sound following current in audio.Names, wrapping around.
+func nextSoundName(current string) string {
+	for i, n := range audio.Names {
+		if n == current {
+			return audio.Names[(i+1)%len(audio.Names)]
+		}
+	}
+	return audio.Names[0]
+}
+
+func soundToggleLabel(enabled bool) string {
+	if enabled {
+		return "Disable reminder sound"
+	}
+	return "Enable reminder sound"
+}
+
+func openInBrowser(url string) {
+	if err := exec.Command("rundll32", "url.dll,FileProtocolHandler", url).Start(); err != nil {
+		fmt.Printf("Error opening update URL %s: %v\n", url, err)
+	}
+}
+
 func showMessage(title, message string) {
 	t, _ := windows.UTF16PtrFromString(title)
 	m, _ := windows.UTF16PtrFromString(message)
@@ -286,14 +692,25 @@ func showAbout() {
 	mainLicensePath := licenseFilePath()
 	thirdPartyLicensesDir := filepath.Join(filepath.Dir(settingsPath()), "THIRD_PARTY_LICENSES")
 
+	updateMu.RLock()
+	updateMessage := computeUpdateMessage(updateDownloadURL, updateAvailable)
+	updateMu.RUnlock()
+	if updateMessage != "" {
+		updateMessage += "\n\n"
+	}
+
 	aboutMessage := fmt.Sprintf(
 		"Better Posture - A posture reminder utility to promote ergonomic habits.\n\n"+
+			"Version: %s\n\n"+
+			"%s"+
 			"Copyright (C) 2025  Rodrigo Toraño Valle\n\n"+
 			"This program is free software: you can redistribute it and/or modify it under the terms of the GNU General Public License as published by the Free Software Foundation, either version 3 of the License, or (at your option) any later version.\n\n"+
 			"This program is distributed in the hope that it will be useful, but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more details.\n\n"+
 			"You should have received a copy of the GNU General Public License along with this program.  If not, see <https://www.gnu.org/licenses/>.\n\n"+
 			"You can find the full GPLv3 license text in:\n%s\n\n"+
 			"Required notices for third-party components (Apache-2.0, BSD-3-Clause) are located in the following folder:\n%s\n\n\n\n",
+		buildVersion,
+		updateMessage,
 		mainLicensePath,
 		thirdPartyLicensesDir,
 	)
@@ -313,6 +730,12 @@ func showAbout() {
 
 var instanceMutex windows.Handle
 var cfgMutex sync.RWMutex
+var settingsWatcher *fsnotify.Watcher
+var scriptWatcher *fsnotify.Watcher
+
+func reminderScriptPath() string {
+	return filepath.Join(filepath.Dir(settingsPath()), "reminder.tengo")
+}
 
 func enforceSingleInstance() bool {
 	const mutexName = "Global\\BetterPostureMutex"
@@ -381,6 +804,14 @@ func onReady() {
 	systray.AddSeparator()
 	mResetDefault := systray.AddMenuItem(fmt.Sprintf("Reset interval (%d min)", defaultInterval), "")
 	systray.AddSeparator()
+	mSoundToggle := systray.AddMenuItem(soundToggleLabel(cfg.SoundEnabled), "Toggle the reminder sound")
+	mSoundName := systray.AddMenuItem(fmt.Sprintf("Sound: %s", cfg.SoundName), "Cycle the reminder sound")
+	systray.AddSeparator()
+	mScheduleToggle := systray.AddMenuItem(scheduleToggleLabel(cfg.Schedule.Enabled), "Toggle quiet-hours / work-schedule")
+	systray.AddSeparator()
+	mUpdate := systray.AddMenuItem("", "Download the latest version")
+	mUpdate.Hide()
+	systray.AddSeparator()
 	mQuit := systray.AddMenuItem("Quit", "Exit program")
 
 	var lastTriggeredUnix int64
@@ -405,19 +836,115 @@ func onReady() {
 			fmt.Printf("Warning: could not save config: %v\n", saveErr)
 		}
 
-		d := time.Duration(cfg.IntervalMinutes) * time.Minute
+		d := time.Duration(newInterval) * time.Minute
+		mIntervalLabel.SetTitle(fmt.Sprintf("Interval: %s", formatDuration(d)))
+	}
+
+	// adjustInterval reads and writes cfg.IntervalMinutes under a single lock, so a
+	// concurrent settings.json hot-reload (which replaces the whole cfg struct from the
+	// watcher goroutine) can't race with an interval button click or clobber its result.
+	adjustInterval := func(delta int) {
+		cfgMutex.Lock()
+		newInterval := cfg.IntervalMinutes + delta
+		if newInterval < minInterval {
+			newInterval = minInterval
+		}
+		if newInterval > maxInterval {
+			newInterval = maxInterval
+		}
+		cfg.IntervalMinutes = newInterval
+		saveErr := saveConfig(cfg)
+		cfgMutex.Unlock()
+
+		if saveErr != nil {
+			fmt.Printf("Warning: could not save config: %v\n", saveErr)
+		}
+
+		d := time.Duration(newInterval) * time.Minute
 		mIntervalLabel.SetTitle(fmt.Sprintf("Interval: %s", formatDuration(d)))
 	}
 
+	runUpdateCheck := func() {
+		checkForUpdate(&cfg)
+
+		updateMu.RLock()
+		available, version := updateAvailable, updateVersion
+		updateMu.RUnlock()
+
+		if available {
+			mUpdate.SetTitle(fmt.Sprintf("Update available: %s", version))
+			mUpdate.Show()
+		}
+	}
+
+	go runUpdateCheck()
+
+	configChanged := make(chan Config, 1)
+	watcher, watchErr := watchSettingsFile(settingsPath(), 500*time.Millisecond, func(updated Config) {
+		cfgMutex.Lock()
+		cfg = updated
+		cfgMutex.Unlock()
+
+		select {
+		case configChanged <- updated:
+		default:
+		}
+	})
+	if watchErr != nil {
+		fmt.Printf("Warning: settings hot-reload disabled: %v\n", watchErr)
+	} else {
+		settingsWatcher = watcher
+	}
+
+	if sw, err := scripts.Watch(reminderScriptPath(), 500*time.Millisecond); err != nil {
+		fmt.Printf("Warning: reminder script hot-reload disabled: %v\n", err)
+	} else {
+		scriptWatcher = sw
+	}
+
 	go func() {
 		ticker := time.NewTicker(1 * time.Second)
 		defer ticker.Stop()
+		updateTicker := time.NewTicker(updateCheckEvery)
+		defer updateTicker.Stop()
 		for {
 			select {
+			case updated := <-configChanged:
+				d := time.Duration(updated.IntervalMinutes) * time.Minute
+				mIntervalLabel.SetTitle(fmt.Sprintf("Interval: %s", formatDuration(d)))
+				mSoundToggle.SetTitle(soundToggleLabel(updated.SoundEnabled))
+				mSoundName.SetTitle(fmt.Sprintf("Sound: %s", updated.SoundName))
+				mScheduleToggle.SetTitle(scheduleToggleLabel(updated.Schedule.Enabled))
+
+			case <-updateTicker.C:
+				go runUpdateCheck()
+
+			case <-mUpdate.ClickedCh:
+				updateMu.RLock()
+				url := updateDownloadURL
+				updateMu.RUnlock()
+				if url != "" {
+					go openInBrowser(url)
+				}
+
 			case <-ticker.C:
+				cfgMutex.RLock()
+				idleSkipMinutes := cfg.IdleSkipMinutes
+				schedule := cfg.Schedule
+				cfgMutex.RUnlock()
+
+				userIdle := idle.Exceeds(idle.Default, time.Duration(idleSkipMinutes)*time.Minute)
+				outsideSchedule := !withinSchedule(time.Now(), schedule)
+
 				if isMessageShowing.Load() {
 					systray.SetTooltip(baseTooltip)
 					mCountdown.SetTitle("Countdown:")
+				} else if userIdle {
+					systray.SetTooltip(fmt.Sprintf("%s (Paused while idle)", baseTooltip))
+					mCountdown.SetTitle("Paused while idle")
+				} else if outsideSchedule {
+					systray.SetTooltip(fmt.Sprintf("%s (Outside work hours)", baseTooltip))
+					mCountdown.SetTitle("Outside work hours")
 				} else {
 					cfgMutex.RLock()
 					intervalMinutes := cfg.IntervalMinutes
@@ -443,14 +970,35 @@ func onReady() {
 				intervalMinutes := cfg.IntervalMinutes
 				cfgMutex.RUnlock()
 
-				if time.Since(last) >= time.Duration(intervalMinutes)*time.Minute && !isMessageShowing.Load() {
+				if userIdle || outsideSchedule {
+					atomic.StoreInt64(&lastTriggeredUnix, time.Now().UnixNano())
+				} else if time.Since(last) >= time.Duration(intervalMinutes)*time.Minute && !isMessageShowing.Load() {
 					isMessageShowing.Store(true)
 
 					cfgMutex.RLock()
 					title := cfg.ReminderTitle
 					message := cfg.ReminderMessage
+					soundEnabled := cfg.SoundEnabled
+					soundName := cfg.SoundName
 					cfgMutex.RUnlock()
 
+					if result, ok := scripts.Run(scripts.HostContext{
+						Now:              time.Now(),
+						MinutesSinceLast: time.Since(last).Minutes(),
+						Weekday:          time.Now().Weekday().String(),
+					}); ok {
+						title = result.Title
+						message = result.Message
+					}
+
+					if soundEnabled {
+						go func() {
+							if err := audio.Play(soundName); err != nil {
+								fmt.Printf("Error playing reminder sound: %v\n", err)
+							}
+						}()
+					}
+
 					go func(tit, msg string) {
 						err := showToast(tit, msg)
 						if err != nil {
@@ -461,29 +1009,62 @@ func onReady() {
 					}(title, message)
 				}
 
+			case <-mSoundToggle.ClickedCh:
+				cfgMutex.Lock()
+				cfg.SoundEnabled = !cfg.SoundEnabled
+				soundEnabled := cfg.SoundEnabled
+				saveErr := saveConfig(cfg)
+				cfgMutex.Unlock()
+				if saveErr != nil {
+					fmt.Printf("Warning: could not save config: %v\n", saveErr)
+				}
+				mSoundToggle.SetTitle(soundToggleLabel(soundEnabled))
+
+			case <-mSoundName.ClickedCh:
+				cfgMutex.Lock()
+				cfg.SoundName = nextSoundName(cfg.SoundName)
+				soundName := cfg.SoundName
+				saveErr := saveConfig(cfg)
+				cfgMutex.Unlock()
+				if saveErr != nil {
+					fmt.Printf("Warning: could not save config: %v\n", saveErr)
+				}
+				mSoundName.SetTitle(fmt.Sprintf("Sound: %s", soundName))
+
+			case <-mScheduleToggle.ClickedCh:
+				cfgMutex.Lock()
+				cfg.Schedule.Enabled = !cfg.Schedule.Enabled
+				scheduleEnabled := cfg.Schedule.Enabled
+				saveErr := saveConfig(cfg)
+				cfgMutex.Unlock()
+				if saveErr != nil {
+					fmt.Printf("Warning: could not save config: %v\n", saveErr)
+				}
+				mScheduleToggle.SetTitle(scheduleToggleLabel(scheduleEnabled))
+
 			case <-mPlus1m.ClickedCh:
-				updateInterval(cfg.IntervalMinutes + 1)
+				adjustInterval(1)
 
 			case <-mMinus1m.ClickedCh:
-				updateInterval(cfg.IntervalMinutes - 1)
+				adjustInterval(-1)
 
 			case <-mPlus5m.ClickedCh:
-				updateInterval(cfg.IntervalMinutes + 5)
+				adjustInterval(5)
 
 			case <-mMinus5m.ClickedCh:
-				updateInterval(cfg.IntervalMinutes - 5)
+				adjustInterval(-5)
 
 			case <-mPlus30m.ClickedCh:
-				updateInterval(cfg.IntervalMinutes + 30)
+				adjustInterval(30)
 
 			case <-mMinus30m.ClickedCh:
-				updateInterval(cfg.IntervalMinutes - 30)
+				adjustInterval(-30)
 
 			case <-mPlus1h.ClickedCh:
-				updateInterval(cfg.IntervalMinutes + 60)
+				adjustInterval(60)
 
 			case <-mMinus1h.ClickedCh:
-				updateInterval(cfg.IntervalMinutes - 60)
+				adjustInterval(-60)
 
 			case <-mResetDefault.ClickedCh:
 				updateInterval(defaultInterval)
@@ -500,6 +1081,14 @@ func onReady() {
 }
 
 func onExit() {
+	if settingsWatcher != nil {
+		_ = settingsWatcher.Close()
+		settingsWatcher = nil
+	}
+	if scriptWatcher != nil {
+		_ = scriptWatcher.Close()
+		scriptWatcher = nil
+	}
 	if instanceMutex != 0 {
 		_ = windows.CloseHandle(instanceMutex)
 		instanceMutex = 0
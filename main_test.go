@@ -0,0 +1,230 @@
+/*
+   Better Posture - A posture reminder utility to promote ergonomic habits.
+   Copyright (C) 2025  Rodrigo Toraño Valle
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFetchUpdateManifest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"version": "v9.9.9", "download_url": "https://example.com/download"}`)
+	}))
+	defer server.Close()
+
+	manifest, err := fetchUpdateManifest(server.URL)
+	if err != nil {
+		t.Fatalf("fetchUpdateManifest returned error: %v", err)
+	}
+	if manifest.Version != "v9.9.9" {
+		t.Errorf("expected version v9.9.9, got %q", manifest.Version)
+	}
+	if manifest.DownloadURL != "https://example.com/download" {
+		t.Errorf("expected download URL, got %q", manifest.DownloadURL)
+	}
+}
+
+func TestFetchUpdateManifestNon200(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	if _, err := fetchUpdateManifest(server.URL); err == nil {
+		t.Fatal("expected an error for a non-200 response, got nil")
+	}
+}
+
+func TestIsOlderVersion(t *testing.T) {
+	cases := []struct {
+		current, latest string
+		want            bool
+	}{
+		{"v1.0.0", "v1.0.0", false},
+		{"v1.0.0", "v1.0.1", true},
+		{"v1.2.0", "v1.1.9", false},
+		{"1.0", "1.0.1", true},
+		{"v2.0.0", "v1.9.9", false},
+	}
+
+	for _, c := range cases {
+		if got := isOlderVersion(c.current, c.latest); got != c.want {
+			t.Errorf("isOlderVersion(%q, %q) = %v, want %v", c.current, c.latest, got, c.want)
+		}
+	}
+}
+
+func TestComputeUpdateMessage(t *testing.T) {
+	if msg := computeUpdateMessage("https://example.com/download", false); msg != "" {
+		t.Errorf("expected empty message when not older, got %q", msg)
+	}
+
+	msg := computeUpdateMessage("https://example.com/download", true)
+	if msg == "" {
+		t.Error("expected a non-empty message when an update is available")
+	}
+
+	msg = computeUpdateMessage("", true)
+	if msg == "" {
+		t.Error("expected a fallback message when the download URL is empty")
+	}
+}
+
+func TestReloadConfigFromDisk(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	data := `{"interval_minutes": 7, "reminder_title": "Stand up", "reminder_message": "Stretch!"}`
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatalf("writing settings file: %v", err)
+	}
+
+	cfg, err := reloadConfigFromDisk(path)
+	if err != nil {
+		t.Fatalf("reloadConfigFromDisk returned error: %v", err)
+	}
+	if cfg.IntervalMinutes != 7 || cfg.ReminderTitle != "Stand up" {
+		t.Errorf("unexpected config: %+v", cfg)
+	}
+}
+
+func TestReloadConfigFromDiskMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+
+	cfg, err := reloadConfigFromDisk(path)
+	if err != nil {
+		t.Fatalf("expected a missing file to be a soft error, got: %v", err)
+	}
+	if cfg.IntervalMinutes != defaultInterval || cfg.ReminderTitle != defaultReminderTitle {
+		t.Errorf("expected defaults for a missing settings file, got: %+v", cfg)
+	}
+}
+
+func TestWatchSettingsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	initial := `{"interval_minutes": 3, "reminder_title": "Posture Reminder", "reminder_message": "Time to check your posture!"}`
+	if err := os.WriteFile(path, []byte(initial), 0644); err != nil {
+		t.Fatalf("writing initial settings file: %v", err)
+	}
+
+	changes := make(chan Config, 1)
+	watcher, err := watchSettingsFile(path, 50*time.Millisecond, func(cfg Config) {
+		changes <- cfg
+	})
+	if err != nil {
+		t.Fatalf("watchSettingsFile returned error: %v", err)
+	}
+	defer watcher.Close()
+
+	updated := `{"interval_minutes": 45, "reminder_title": "Posture Reminder", "reminder_message": "Time to check your posture!"}`
+	if err := os.WriteFile(path, []byte(updated), 0644); err != nil {
+		t.Fatalf("updating settings file: %v", err)
+	}
+
+	select {
+	case cfg := <-changes:
+		if cfg.IntervalMinutes != 45 {
+			t.Errorf("expected updated interval 45, got %d", cfg.IntervalMinutes)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for watcher to pick up the change")
+	}
+}
+
+func TestWithinSchedule(t *testing.T) {
+	weekdays := []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday"}
+
+	cases := []struct {
+		name  string
+		now   time.Time
+		sched ScheduleConfig
+		want  bool
+	}{
+		{
+			name:  "disabled schedule never restricts",
+			now:   time.Date(2026, time.July, 25, 3, 0, 0, 0, time.UTC), // a Saturday, 3am
+			sched: ScheduleConfig{Enabled: false, Start: "09:00", End: "17:00", Days: weekdays},
+			want:  true,
+		},
+		{
+			name:  "inside the window on a scheduled day",
+			now:   time.Date(2026, time.July, 27, 12, 0, 0, 0, time.UTC), // a Monday, noon
+			sched: ScheduleConfig{Enabled: true, Start: "09:00", End: "17:00", Days: weekdays},
+			want:  true,
+		},
+		{
+			name:  "outside the window on a scheduled day",
+			now:   time.Date(2026, time.July, 27, 20, 0, 0, 0, time.UTC), // a Monday, 8pm
+			sched: ScheduleConfig{Enabled: true, Start: "09:00", End: "17:00", Days: weekdays},
+			want:  false,
+		},
+		{
+			name:  "inside the window on a day not scheduled",
+			now:   time.Date(2026, time.July, 25, 12, 0, 0, 0, time.UTC), // a Saturday, noon
+			sched: ScheduleConfig{Enabled: true, Start: "09:00", End: "17:00", Days: weekdays},
+			want:  false,
+		},
+		{
+			name:  "overnight window wraps past midnight",
+			now:   time.Date(2026, time.July, 27, 23, 0, 0, 0, time.UTC), // a Monday, 11pm
+			sched: ScheduleConfig{Enabled: true, Start: "22:00", End: "06:00", Days: weekdays},
+			want:  true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := withinSchedule(c.now, c.sched); got != c.want {
+				t.Errorf("withinSchedule(%v, %+v) = %v, want %v", c.now, c.sched, got, c.want)
+			}
+		})
+	}
+}
+
+func TestIsValidTimeOfDay(t *testing.T) {
+	valid := []string{"00:00", "09:30", "23:59"}
+	invalid := []string{"", "24:00", "9:5", "9:60", "not-a-time"}
+
+	for _, v := range valid {
+		if !isValidTimeOfDay(v) {
+			t.Errorf("expected %q to be a valid time of day", v)
+		}
+	}
+	for _, v := range invalid {
+		if isValidTimeOfDay(v) {
+			t.Errorf("expected %q to be an invalid time of day", v)
+		}
+	}
+}
+
+func TestAreValidWeekdays(t *testing.T) {
+	if !areValidWeekdays([]string{"Monday", "friday"}) {
+		t.Error("expected case-insensitive weekday names to validate")
+	}
+	if areValidWeekdays(nil) {
+		t.Error("expected an empty day list to be invalid, falling back to defaults")
+	}
+	if areValidWeekdays([]string{"Monday", "Someday"}) {
+		t.Error("expected an unknown weekday name to invalidate the list")
+	}
+}
@@ -0,0 +1,222 @@
+/*
+   Better Posture - A posture reminder utility to promote ergonomic habits.
+   Copyright (C) 2025  Rodrigo Toraño Valle
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Package scripts lets power users override the static reminder title/message by
+// dropping a reminder.tengo file next to settings.json. The script is run, under a
+// short deadline, each time a reminder is about to fire; any error falls back silently
+// to the configured static strings.
+package scripts
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/fsnotify/fsnotify"
+)
+
+const execTimeout = 100 * time.Millisecond
+
+// StretchSuggestions are canned break-exercise prompts, exposed to scripts as the
+// stretch_suggestions global so they can rotate through them based on context.
+var StretchSuggestions = []string{
+	"Look 20ft away for 20 seconds",
+	"Roll your shoulders backward 10 times",
+	"Stand up and stretch your hamstrings",
+	"Tuck your chin and stretch the back of your neck",
+	"Stand up and walk to get some water",
+}
+
+// stretchSuggestionsGlobal mirrors StretchSuggestions as []interface{}, since Tengo's
+// Script.Add only accepts the types its FromInterface conversion understands and rejects
+// a bare []string.
+func stretchSuggestionsGlobal() []interface{} {
+	suggestions := make([]interface{}, len(StretchSuggestions))
+	for i, s := range StretchSuggestions {
+		suggestions[i] = s
+	}
+	return suggestions
+}
+
+// HostContext carries the values exposed to the script as globals before each run.
+type HostContext struct {
+	Now              time.Time
+	MinutesSinceLast float64
+	Weekday          string
+}
+
+// Result is what a script returns by setting the title and message globals.
+type Result struct {
+	Title   string
+	Message string
+}
+
+var (
+	mu       sync.Mutex
+	compiled *tengo.Compiled
+)
+
+// Load compiles the script at path, if present. A missing file clears any previously
+// loaded script rather than returning an error — callers fall back to the static
+// reminder strings when no script is loaded.
+func Load(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			mu.Lock()
+			compiled = nil
+			mu.Unlock()
+			return nil
+		}
+		return fmt.Errorf("reading script %s: %w", path, err)
+	}
+
+	script := tengo.NewScript(data)
+	globals := []struct {
+		name  string
+		value interface{}
+	}{
+		{"now_unix", int64(0)},
+		{"minutes_since_last", float64(0)},
+		{"weekday", ""},
+		{"stretch_suggestions", stretchSuggestionsGlobal()},
+		{"title", ""},
+		{"message", ""},
+	}
+	for _, g := range globals {
+		if err := script.Add(g.name, g.value); err != nil {
+			return fmt.Errorf("registering script global %s: %w", g.name, err)
+		}
+	}
+
+	c, err := script.Compile()
+	if err != nil {
+		return fmt.Errorf("compiling script %s: %w", path, err)
+	}
+
+	mu.Lock()
+	compiled = c
+	mu.Unlock()
+	return nil
+}
+
+// Run executes the loaded script, if any, with a 100ms execution deadline and returns
+// the {title, message} it produced. ok is false whenever no script is loaded, it errors,
+// or it fails to set both globals — the caller should fall back to the static strings.
+func Run(ctx HostContext) (result Result, ok bool) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if compiled == nil {
+		return Result{}, false
+	}
+
+	sets := []struct {
+		name  string
+		value interface{}
+	}{
+		{"now_unix", ctx.Now.Unix()},
+		{"minutes_since_last", ctx.MinutesSinceLast},
+		{"weekday", ctx.Weekday},
+		// Compiled reuses the same globals across RunContext calls, so title/message must
+		// be reset before every run or a script that only sets them conditionally would
+		// leak a previous run's values forward instead of falling back to the static strings.
+		{"title", ""},
+		{"message", ""},
+	}
+	for _, s := range sets {
+		if err := compiled.Set(s.name, s.value); err != nil {
+			fmt.Printf("Warning: could not set script global %s, falling back to the static message: %v\n", s.name, err)
+			return Result{}, false
+		}
+	}
+
+	deadline, cancel := context.WithTimeout(context.Background(), execTimeout)
+	defer cancel()
+
+	if err := compiled.RunContext(deadline); err != nil {
+		fmt.Printf("Warning: reminder script failed, falling back to the static message: %v\n", err)
+		return Result{}, false
+	}
+
+	title, titleOK := compiled.Get("title").Value().(string)
+	message, messageOK := compiled.Get("message").Value().(string)
+	if !titleOK || !messageOK || title == "" || message == "" {
+		return Result{}, false
+	}
+
+	return Result{Title: title, Message: message}, true
+}
+
+// Watch loads the script at path and recompiles it on every subsequent fsnotify event
+// for that file, debounced by the given duration. The returned watcher must be closed
+// by the caller on shutdown.
+func Watch(path string, debounce time.Duration) (*fsnotify.Watcher, error) {
+	if err := Load(path); err != nil {
+		fmt.Printf("Warning: could not load reminder script: %v\n", err)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("creating script watcher: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("watching script directory %s: %w", dir, err)
+	}
+
+	go func() {
+		var timer *time.Timer
+
+		reload := func() {
+			if err := Load(path); err != nil {
+				fmt.Printf("Warning: could not reload reminder script: %v\n", err)
+			}
+		}
+
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if event.Name != path || event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Remove) == 0 {
+					continue
+				}
+				if timer != nil {
+					timer.Stop()
+				}
+				timer = time.AfterFunc(debounce, reload)
+
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				fmt.Printf("Script watcher error: %v\n", err)
+			}
+		}
+	}()
+
+	return watcher, nil
+}
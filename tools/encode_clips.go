@@ -0,0 +1,129 @@
+/*
+   Better Posture - A posture reminder utility to promote ergonomic habits.
+   Copyright (C) 2025  Rodrigo Toraño Valle
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU General Public License for more details.
+
+   You should have received a copy of the GNU General Public License
+   along with this program.  If not, see <https://www.gnu.org/licenses/>.
+*/
+
+// Command encode_clips converts mono 48kHz 16-bit PCM WAV source recordings into the
+// length-prefixed raw-Opus-frame files embedded by the audio package. It is a build-time
+// tool, not something the app runs, so it lives outside the audio package and is invoked
+// by hand whenever a clip's source recording changes:
+//
+//	go run tools/encode_clips.go -in clips_src -out audio/clips
+//
+// The output format deliberately skips a full Ogg container: each frame is written as a
+// little-endian uint16 byte length followed by that many bytes of Opus packet data,
+// which audio.decode can read back with nothing more than encoding/binary and an Opus
+// decoder.
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-audio/wav"
+	"github.com/hraban/opus"
+)
+
+const (
+	sampleRate = 48000
+	channels   = 1
+	frameSize  = sampleRate / 50 // 20ms frames, matching audio.decode's expectation
+)
+
+func main() {
+	inDir := flag.String("in", "clips_src", "directory of source .wav recordings")
+	outDir := flag.String("out", "audio/clips", "directory to write .opus clips to")
+	flag.Parse()
+
+	entries, err := os.ReadDir(*inDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "reading %s: %v\n", *inDir, err)
+		os.Exit(1)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.EqualFold(filepath.Ext(entry.Name()), ".wav") {
+			continue
+		}
+		if err := encodeClip(*inDir, *outDir, entry); err != nil {
+			fmt.Fprintf(os.Stderr, "encoding %s: %v\n", entry.Name(), err)
+			os.Exit(1)
+		}
+	}
+}
+
+func encodeClip(inDir, outDir string, entry fs.DirEntry) error {
+	src := filepath.Join(inDir, entry.Name())
+	f, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", src, err)
+	}
+	defer f.Close()
+
+	decoder := wav.NewDecoder(f)
+	buf, err := decoder.FullPCMBuffer()
+	if err != nil {
+		return fmt.Errorf("reading wav data: %w", err)
+	}
+	if buf.Format.SampleRate != sampleRate || buf.Format.NumChannels != channels {
+		return fmt.Errorf("expected %dHz mono, got %dHz %d-channel", sampleRate, buf.Format.SampleRate, buf.Format.NumChannels)
+	}
+
+	enc, err := opus.NewEncoder(sampleRate, channels, opus.AppAudio)
+	if err != nil {
+		return fmt.Errorf("creating opus encoder: %w", err)
+	}
+
+	samples := buf.AsIntBuffer().Data
+
+	var out bytes.Buffer
+	frame := make([]int16, frameSize)
+	packet := make([]byte, 4000)
+
+	for offset := 0; offset < len(samples); offset += frameSize {
+		for i := range frame {
+			if offset+i < len(samples) {
+				frame[i] = int16(samples[offset+i])
+			} else {
+				frame[i] = 0
+			}
+		}
+
+		n, err := enc.Encode(frame, packet)
+		if err != nil {
+			return fmt.Errorf("encoding frame at sample %d: %w", offset, err)
+		}
+
+		if err := binary.Write(&out, binary.LittleEndian, uint16(n)); err != nil {
+			return fmt.Errorf("writing frame header: %w", err)
+		}
+		out.Write(packet[:n])
+	}
+
+	name := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name())) + ".opus"
+	dst := filepath.Join(outDir, name)
+	if err := os.WriteFile(dst, out.Bytes(), 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", dst, err)
+	}
+
+	return nil
+}